@@ -3,6 +3,7 @@ package asana // import "bitbucket.org/mikehouston/asana-go"
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,10 +14,12 @@ import (
 	"net/textproto"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/imdario/mergo"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -34,6 +37,28 @@ type Client struct {
 	Verbose        []bool
 	FastAPI        bool
 	DefaultOptions Options
+
+	// MaxRetries is the number of times a request will be retried after a
+	// transient failure (a connection error, a 5xx response, or a 429
+	// rate-limit response) before giving up. Zero, the default, disables
+	// retries entirely so existing callers see no behavior change.
+	MaxRetries int
+
+	// RetryWaitMin and RetryWaitMax bound the delay between retries. The
+	// delay is exponential in the attempt number, jittered, and capped at
+	// RetryWaitMax; a Retry-After header, when present, takes precedence.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// Backoff computes the delay before the given retry attempt (1-indexed).
+	// resp is the response that triggered the retry, or nil for a connection
+	// error. When unset, DefaultBackoff is used.
+	Backoff func(attempt int, resp *http.Response) time.Duration
+
+	// RateLimiter, when set, is waited on before every outbound request so
+	// that heavy callers can proactively stay under Asana's concurrent
+	// request cap.
+	RateLimiter *rate.Limiter
 }
 
 // NewClient instantiates a new Asana client with the given HTTP client and
@@ -41,9 +66,11 @@ type Client struct {
 func NewClient(httpClient *http.Client) *Client {
 	u, _ := url.Parse(BaseURL)
 	return &Client{
-		BaseURL:    u,
-		FastAPI:    true,
-		HTTPClient: httpClient,
+		BaseURL:      u,
+		FastAPI:      true,
+		HTTPClient:   httpClient,
+		RetryWaitMin: 1 * time.Second,
+		RetryWaitMax: 30 * time.Second,
 	}
 }
 
@@ -63,7 +90,7 @@ type NextPage struct {
 type Response struct {
 	Data     json.RawMessage `json:"data"`
 	NextPage *NextPage       `json:"next_page"`
-	Errors   []*Error        `json:"errors"`
+	Errors   []*ErrorDetail  `json:"errors"`
 }
 
 func (c *Client) getURL(path string) string {
@@ -91,6 +118,10 @@ func mergeQuery(q url.Values, request interface{}) error {
 }
 
 func (c *Client) get(path string, data, result interface{}, opts ...*Options) (*NextPage, error) {
+	return c.getWithContext(context.Background(), path, data, result, opts...)
+}
+
+func (c *Client) getWithContext(ctx context.Context, path string, data, result interface{}, opts ...*Options) (*NextPage, error) {
 
 	// Encode default options
 	if c.Debug {
@@ -136,14 +167,16 @@ func (c *Client) get(path string, data, result interface{}, opts ...*Options) (*
 	if c.Debug {
 		log.Printf("GET %s", path)
 	}
-	request, err := http.NewRequest(http.MethodGet, c.getURL(path), nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "Request error")
-	}
-	if c.FastAPI {
-		request.Header.Add("Asana-Fast-Api", "true")
-	}
-	resp, err := c.HTTPClient.Do(request)
+	resp, err := c.sendWithRetry(ctx, true, func() (*http.Request, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getURL(path), nil)
+		if err != nil {
+			return nil, err
+		}
+		if c.FastAPI {
+			request.Header.Add("Asana-Fast-Api", "true")
+		}
+		return request, nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "GET error")
 	}
@@ -158,14 +191,30 @@ func (c *Client) get(path string, data, result interface{}, opts ...*Options) (*
 }
 
 func (c *Client) post(path string, data, result interface{}, opts ...*Options) error {
-	return c.do(http.MethodPost, path, data, result, opts...)
+	return c.do(context.Background(), http.MethodPost, path, data, result, opts...)
+}
+
+func (c *Client) postWithContext(ctx context.Context, path string, data, result interface{}, opts ...*Options) error {
+	return c.do(ctx, http.MethodPost, path, data, result, opts...)
 }
 
 func (c *Client) put(path string, data, result interface{}, opts ...*Options) error {
-	return c.do(http.MethodPut, path, data, result, opts...)
+	return c.do(context.Background(), http.MethodPut, path, data, result, opts...)
 }
 
-func (c *Client) do(method, path string, data, result interface{}, opts ...*Options) error {
+func (c *Client) putWithContext(ctx context.Context, path string, data, result interface{}, opts ...*Options) error {
+	return c.do(ctx, http.MethodPut, path, data, result, opts...)
+}
+
+func (c *Client) delete(path string, opts ...*Options) error {
+	return c.do(context.Background(), http.MethodDelete, path, nil, nil, opts...)
+}
+
+func (c *Client) deleteWithContext(ctx context.Context, path string, opts ...*Options) error {
+	return c.do(ctx, http.MethodDelete, path, nil, nil, opts...)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, data, result interface{}, opts ...*Options) error {
 	// Prepare options
 	var options *Options
 	if opts != nil {
@@ -202,16 +251,23 @@ func (c *Client) do(method, path string, data, result interface{}, opts ...*Opti
 		body, _ := json.MarshalIndent(req, "", "  ")
 		log.Printf("%s %s\n%s", method, path, body)
 	}
-	request, err := http.NewRequest(method, c.getURL(path), bytes.NewReader(body))
-	if err != nil {
-		return errors.Wrap(err, "Request error")
-	}
+	// POST isn't idempotent in general (a lost response after a successful
+	// write must not be retried into a duplicate create), so it's only
+	// retried when the caller marked ctx with WithIdempotentRetry. PUT and
+	// DELETE are safe to retry unconditionally.
+	idempotent := method != http.MethodPost || isIdempotentRetry(ctx)
 
-	request.Header.Add("Content-Type", "application/json")
-	if c.FastAPI {
-		request.Header.Add("Asana-Fast-Api", "true")
-	}
-	resp, err := c.HTTPClient.Do(request)
+	resp, err := c.sendWithRetry(ctx, idempotent, func() (*http.Request, error) {
+		request, err := http.NewRequestWithContext(ctx, method, c.getURL(path), bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Add("Content-Type", "application/json")
+		if c.FastAPI {
+			request.Header.Add("Asana-Fast-Api", "true")
+		}
+		return request, nil
+	})
 	if err != nil {
 		return errors.Wrapf(err, "%s error", method)
 	}
@@ -230,6 +286,17 @@ func escapeQuotes(s string) string {
 // --------
 
 func (c *Client) postMultipart(path string, result interface{}, field string, r io.ReadCloser, filename string, contentType string) error {
+	return c.postMultipartWithContext(context.Background(), path, result, field, r, filename, contentType)
+}
+
+// postMultipartWithContext uploads r as a multipart form field. Like any
+// other POST, this isn't retried unless ctx was marked with
+// WithIdempotentRetry. A retried request must re-send the same body, which
+// an io.ReadCloser can't do on its own, so r is only read into memory in
+// full when a retry could actually happen (ctx is marked idempotent and
+// MaxRetries is greater than zero); otherwise r is streamed directly with
+// no buffering.
+func (c *Client) postMultipartWithContext(ctx context.Context, path string, result interface{}, field string, r io.ReadCloser, filename string, contentType string) error {
 	// Make request
 	if c.Debug {
 		log.Printf("POST multipart %s\n%s=%s;ContentType=%s", path, field, filename, contentType)
@@ -256,20 +323,45 @@ func (c *Client) postMultipart(path string, result interface{}, field string, r
 		return errors.Wrap(err, "create multipart footer")
 	}
 
-	// Create request
-	request, err := http.NewRequest(http.MethodPost, c.getURL(path), io.MultiReader(
-		bytes.NewReader(buffer.Bytes()[:headerSize]),
-		r,
-		bytes.NewReader(buffer.Bytes()[headerSize:])))
-	if err != nil {
-		return errors.Wrap(err, "Request error")
-	}
+	contentType2 := partWriter.FormDataContentType()
 
-	request.Header.Add("Content-Type", partWriter.FormDataContentType())
-	if c.FastAPI {
-		request.Header.Add("Asana-Fast-Api", "true")
+	// A multipart upload is a POST, so like any other POST it isn't retried
+	// unless the caller marked ctx with WithIdempotentRetry. Only buffer the
+	// body into memory when a retry could actually happen.
+	idempotent := isIdempotentRetry(ctx)
+
+	var content []byte
+	if idempotent && c.MaxRetries > 0 {
+		content, err = ioutil.ReadAll(r)
+		if err != nil {
+			return errors.Wrap(err, "read multipart body")
+		}
 	}
-	resp, err := c.HTTPClient.Do(request)
+
+	resp, err := c.sendWithRetry(ctx, idempotent, func() (*http.Request, error) {
+		// When retries can't happen, r is streamed directly and can only be
+		// read once, which is fine since this closure then only ever runs a
+		// single time. Otherwise content was buffered above so each attempt
+		// gets its own fresh reader over it.
+		var body io.Reader = r
+		if idempotent && c.MaxRetries > 0 {
+			body = bytes.NewReader(content)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getURL(path), io.MultiReader(
+			bytes.NewReader(buffer.Bytes()[:headerSize]),
+			body,
+			bytes.NewReader(buffer.Bytes()[headerSize:])))
+		if err != nil {
+			return nil, err
+		}
+
+		request.Header.Add("Content-Type", contentType2)
+		if c.FastAPI {
+			request.Header.Add("Asana-Fast-Api", "true")
+		}
+		return request, nil
+	})
 	if err != nil {
 		return errors.Wrapf(err, "POST error")
 	}
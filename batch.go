@@ -0,0 +1,217 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/go-querystring/query"
+	"github.com/pkg/errors"
+)
+
+// batchChunkSize is the maximum number of actions Asana accepts in a single
+// POST /batch call.
+const batchChunkSize = 10
+
+// BatchResult is a handle to the outcome of one action queued on a
+// BatchRequest. It is only valid to inspect after Execute returns.
+type BatchResult struct {
+	statusCode int
+	body       json.RawMessage
+	err        error
+}
+
+// Err returns the error returned for this action's sub-response, if Asana
+// reported one, or the error that prevented the whole chunk from executing.
+func (r *BatchResult) Err() error {
+	return r.err
+}
+
+// StatusCode returns the HTTP status code Asana reported for this action's
+// sub-response. It is zero if the chunk failed outright before any
+// sub-response was demultiplexed.
+func (r *BatchResult) StatusCode() int {
+	return r.statusCode
+}
+
+// Decode unmarshals this action's response body into v. It returns the
+// action's error, if any, without attempting to decode.
+func (r *BatchResult) Decode(v interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return json.Unmarshal(r.body, v)
+}
+
+type batchAction struct {
+	RelativePath string      `json:"relative_path"`
+	Method       string      `json:"method"`
+	Data         interface{} `json:"data,omitempty"`
+}
+
+type batchActionsRequest struct {
+	Actions []batchAction `json:"actions"`
+}
+
+type batchSubResponse struct {
+	StatusCode int             `json:"status_code"`
+	Body       json.RawMessage `json:"body"`
+}
+
+// batchSubResponseErrorBody is the shape of a sub-response body on failure,
+// matching the top-level Response.Errors envelope.
+type batchSubResponseErrorBody struct {
+	Errors []*ErrorDetail `json:"errors"`
+}
+
+// BatchRequest queues a set of sub-requests to be sent to Asana's
+// POST /batch endpoint as one or more HTTP round-trips, ten actions at a
+// time.
+type BatchRequest struct {
+	client  *Client
+	actions []batchAction
+	results []*BatchResult
+}
+
+// Batch returns a new BatchRequest builder for queuing sub-requests.
+func (c *Client) Batch() *BatchRequest {
+	return &BatchRequest{client: c}
+}
+
+func (b *BatchRequest) queue(method, path string, data interface{}) *BatchResult {
+	result := &BatchResult{}
+	b.actions = append(b.actions, batchAction{
+		RelativePath: path,
+		Method:       method,
+		Data:         data,
+	})
+	b.results = append(b.results, result)
+	return result
+}
+
+// Get queues a GET sub-request for path. opts is marshaled into query
+// parameters on the relative path.
+func (b *BatchRequest) Get(path string, opts *Options) *BatchResult {
+	if opts != nil {
+		if values, err := query.Values(opts); err == nil && len(values) > 0 {
+			path = path + "?" + values.Encode()
+		}
+	}
+	return b.queue("get", path, nil)
+}
+
+// Post queues a POST sub-request for path with the given request body.
+func (b *BatchRequest) Post(path string, data interface{}) *BatchResult {
+	return b.queue("post", path, data)
+}
+
+// Put queues a PUT sub-request for path with the given request body.
+func (b *BatchRequest) Put(path string, data interface{}) *BatchResult {
+	return b.queue("put", path, data)
+}
+
+// Execute sends all queued actions, ten at a time, concurrently, and
+// demultiplexes each sub-response back onto the BatchResult handle returned
+// when it was queued. It returns an error only when a chunk failed outright
+// (e.g. the batch call itself returned a non-2xx); per-action failures are
+// reported through that action's BatchResult instead.
+func (b *BatchRequest) Execute(ctx context.Context) error {
+	var wg sync.WaitGroup
+	errs := make([]error, (len(b.actions)+batchChunkSize-1)/batchChunkSize)
+
+	for i := 0; i*batchChunkSize < len(b.actions); i++ {
+		start := i * batchChunkSize
+		end := start + batchChunkSize
+		if end > len(b.actions) {
+			end = len(b.actions)
+		}
+
+		wg.Add(1)
+		go func(i, start, end int) {
+			defer wg.Done()
+			errs[i] = b.executeChunk(ctx, b.actions[start:end], b.results[start:end])
+		}(i, start, end)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BatchRequest) executeChunk(ctx context.Context, actions []batchAction, results []*BatchResult) error {
+	body := &batchActionsRequest{Actions: actions}
+	var subResponses []batchSubResponse
+
+	if err := b.client.postWithContext(ctx, "/batch", body, &subResponses); err != nil {
+		for _, result := range results {
+			result.err = err
+		}
+		return errors.Wrap(err, "batch request failed")
+	}
+
+	for i, result := range results {
+		if i >= len(subResponses) {
+			result.err = errors.New("missing batch sub-response")
+			continue
+		}
+		sub := subResponses[i]
+		result.statusCode = sub.StatusCode
+		if sub.StatusCode < 200 || sub.StatusCode >= 300 {
+			var errBody batchSubResponseErrorBody
+			json.Unmarshal(sub.Body, &errBody) // best-effort; fall through with no details on failure
+
+			errs := make([]ErrorDetail, len(errBody.Errors))
+			for j, detail := range errBody.Errors {
+				if detail != nil {
+					errs[j] = *detail
+				}
+			}
+
+			result.err = &APIError{
+				StatusCode: sub.StatusCode,
+				Errors:     errs,
+			}
+			continue
+		}
+		result.body = sub.Body
+	}
+
+	return nil
+}
+
+// BatchFetchProjects fetches the given project IDs in as few round-trips as
+// possible using the batch API.
+func BatchFetchProjects(client *Client, ids []string) ([]*Project, error) {
+	return BatchFetchProjectsContext(context.Background(), client, ids)
+}
+
+// BatchFetchProjectsContext fetches the given project IDs in as few
+// round-trips as possible using the batch API, honoring ctx's deadline and
+// cancellation.
+func BatchFetchProjectsContext(ctx context.Context, client *Client, ids []string) ([]*Project, error) {
+	batch := client.Batch()
+	results := make([]*BatchResult, len(ids))
+	for i, id := range ids {
+		results[i] = batch.Get(fmt.Sprintf("/projects/%s", id), nil)
+	}
+
+	if err := batch.Execute(ctx); err != nil {
+		return nil, err
+	}
+
+	projects := make([]*Project, len(ids))
+	for i, result := range results {
+		project := &Project{}
+		if err := result.Decode(project); err != nil {
+			return nil, errors.Wrapf(err, "decoding project %s", ids[i])
+		}
+		projects[i] = project
+	}
+	return projects, nil
+}
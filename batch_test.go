@@ -0,0 +1,133 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// batchTestServer fakes POST /batch: for each queued action it echoes back a
+// success sub-response carrying the relative path, except for paths ending
+// in "/fail" which get a 404 with a structured error body.
+func batchTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Data struct {
+				Actions []struct {
+					RelativePath string `json:"relative_path"`
+				} `json:"actions"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode batch request: %v", err)
+		}
+
+		subResponses := make([]map[string]interface{}, len(req.Data.Actions))
+		for i, action := range req.Data.Actions {
+			if action.RelativePath == "/projects/fail" {
+				subResponses[i] = map[string]interface{}{
+					"status_code": 404,
+					"body": map[string]interface{}{
+						"errors": []map[string]string{{"message": "project not found"}},
+					},
+				}
+				continue
+			}
+			subResponses[i] = map[string]interface{}{
+				"status_code": 200,
+				"body":        map[string]interface{}{"gid": action.RelativePath},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"data": subResponses}); err != nil {
+			t.Fatalf("encode batch response: %v", err)
+		}
+	}))
+}
+
+func TestBatchExecuteDemuxesAcrossChunks(t *testing.T) {
+	server := batchTestServer(t)
+	defer server.Close()
+
+	client := NewClient(http.DefaultClient)
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	// 13 actions spans two chunks of 10 and 3, exercising index alignment
+	// across a chunk boundary as well as within a chunk.
+	ids := make([]string, 0, 13)
+	for i := 0; i < 12; i++ {
+		ids = append(ids, fmt.Sprintf("/projects/project-%d", i))
+	}
+	ids = append(ids, "/projects/fail")
+
+	batch := client.Batch()
+	results := make([]*BatchResult, len(ids))
+	for i, id := range ids {
+		results[i] = batch.Get(id, nil)
+	}
+
+	if err := batch.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	for i, result := range results {
+		if ids[i] == "/projects/fail" {
+			apiErr, ok := result.Err().(*APIError)
+			if !ok {
+				t.Fatalf("result %d: expected *APIError, got %T (%v)", i, result.Err(), result.Err())
+			}
+			if apiErr.StatusCode != http.StatusNotFound {
+				t.Fatalf("result %d: StatusCode = %d, want %d", i, apiErr.StatusCode, http.StatusNotFound)
+			}
+			if result.StatusCode() != http.StatusNotFound {
+				t.Fatalf("result %d: BatchResult.StatusCode() = %d, want %d", i, result.StatusCode(), http.StatusNotFound)
+			}
+			if !IsNotFound(result.Err()) {
+				t.Fatalf("result %d: IsNotFound should be true for a 404 sub-response", i)
+			}
+			if len(apiErr.Errors) != 1 || apiErr.Errors[0].Message != "project not found" {
+				t.Fatalf("result %d: error details = %+v, want the parsed sub-response body", i, apiErr.Errors)
+			}
+			continue
+		}
+
+		var project struct {
+			ID string `json:"gid"`
+		}
+		if err := result.Decode(&project); err != nil {
+			t.Fatalf("result %d: Decode: %v", i, err)
+		}
+		if project.ID != ids[i] {
+			t.Fatalf("result %d misaligned: decoded gid %q, want %q", i, project.ID, ids[i])
+		}
+		if result.StatusCode() != http.StatusOK {
+			t.Fatalf("result %d: StatusCode() = %d, want 200", i, result.StatusCode())
+		}
+	}
+}
+
+func TestBatchFetchProjects(t *testing.T) {
+	server := batchTestServer(t)
+	defer server.Close()
+
+	client := NewClient(http.DefaultClient)
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	projects, err := BatchFetchProjects(client, []string{"1", "2", "3"})
+	if err != nil {
+		t.Fatalf("BatchFetchProjects: %v", err)
+	}
+	if len(projects) != 3 {
+		t.Fatalf("got %d projects, want 3", len(projects))
+	}
+	for i, want := range []string{"/projects/1", "/projects/2", "/projects/3"} {
+		if projects[i].ID != want {
+			t.Fatalf("project %d ID = %q, want %q", i, projects[i].ID, want)
+		}
+	}
+}
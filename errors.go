@@ -0,0 +1,116 @@
+package asana
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrorDetail is a single error entry as returned by the Asana API, e.g.
+//
+//	{"message": "project: Missing input", "help": "...", "phrase": "6 sad squid..."}
+type ErrorDetail struct {
+	// Message is a human-readable description of what went wrong.
+	Message string `json:"message"`
+
+	// Help points to API documentation relevant to the error, when Asana
+	// provides one.
+	Help string `json:"help,omitempty"`
+
+	// Phrase is a whimsical phrase Asana includes on 500 errors to make bug
+	// reports to their support team easier to locate in their logs.
+	Phrase string `json:"phrase,omitempty"`
+}
+
+// Sentinel error kinds that APIError wraps, so callers can use errors.Is
+// instead of inspecting status codes or message strings.
+var (
+	ErrNotFound       = errors.New("asana: not found")
+	ErrUnauthorized   = errors.New("asana: unauthorized")
+	ErrForbidden      = errors.New("asana: forbidden")
+	ErrRateLimited    = errors.New("asana: rate limited")
+	ErrInvalidRequest = errors.New("asana: invalid request")
+	ErrServerError    = errors.New("asana: server error")
+)
+
+// APIError is returned for a non-2xx response from the Asana API whose body
+// decodes as JSON; a body that does not decode yields the JSON decoding error
+// instead of an APIError. It carries the structured error details from the
+// response body along with the request ID Asana support uses to look up what
+// happened on their end.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Errors is the list of error details parsed from the response body.
+	Errors []ErrorDetail
+
+	// RequestID is the value of the X-Asana-Request-Id response header, if
+	// present, for inclusion in bug reports to Asana support.
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	message := "unknown error"
+	if len(e.Errors) > 0 {
+		message = e.Errors[0].Message
+	}
+	if e.RequestID != "" {
+		return fmt.Sprintf("asana: %s (status %d, request %s)", message, e.StatusCode, e.RequestID)
+	}
+	return fmt.Sprintf("asana: %s (status %d)", message, e.StatusCode)
+}
+
+// Is allows errors.Is(err, ErrNotFound) and friends to classify an APIError
+// by its status code rather than requiring callers to compare StatusCode
+// directly.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrInvalidRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrServerError:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// IsRetryable reports whether err represents a transient failure (a 429 or
+// a 5xx) that is safe to retry.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableStatus(apiErr.StatusCode)
+	}
+	return false
+}
+
+// IsNotFound reports whether err represents a 404 response from the Asana
+// API.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// Error builds the APIError for a non-2xx response, capturing the parsed
+// error details and the X-Asana-Request-Id header.
+func (value *Response) Error(resp *http.Response) error {
+	errs := make([]ErrorDetail, len(value.Errors))
+	for i, detail := range value.Errors {
+		if detail != nil {
+			errs[i] = *detail
+		}
+	}
+
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Errors:     errs,
+		RequestID:  resp.Header.Get("X-Asana-Request-Id"),
+	}
+}
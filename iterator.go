@@ -0,0 +1,165 @@
+package asana
+
+import "context"
+
+// ProjectIterator walks the pages of a project listing transparently,
+// fetching the next page only when the caller asks for it. Its zero value
+// is not usable; create one with Workspace.ProjectsIter.
+//
+// Usage mirrors sql.Rows:
+//
+//	it := workspace.ProjectsIter(client)
+//	for it.Next(ctx) {
+//		project := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ProjectIterator struct {
+	client    *Client
+	workspace *Workspace
+	options   []*Options
+
+	started  bool
+	nextPage *NextPage
+	page     []*Project
+	index    int
+	err      error
+}
+
+// ProjectsIter returns an iterator over all projects in this workspace,
+// paging through the results transparently as Next is called.
+func (w *Workspace) ProjectsIter(client *Client, options ...*Options) *ProjectIterator {
+	return &ProjectIterator{
+		client:    client,
+		workspace: w,
+		options:   options,
+	}
+}
+
+// Next advances the iterator to the next project, fetching the next page
+// from the API if the current one is exhausted. It returns false when
+// iteration is complete or when ctx is done at the point a new page has to
+// be fetched; projects already on the current page are still returned after
+// ctx is done. Callers should check Err to distinguish the two.
+func (it *ProjectIterator) Next(ctx context.Context) bool {
+	for it.index >= len(it.page) {
+		if it.started && it.nextPage == nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		page := &Options{Limit: 100}
+		if it.nextPage != nil {
+			page.Offset = it.nextPage.Offset
+		}
+		allOptions := append([]*Options{page}, it.options...)
+
+		projects, nextPage, err := it.workspace.ProjectsContext(ctx, it.client, allOptions...)
+		it.started = true
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page = projects
+		it.index = 0
+		it.nextPage = nextPage
+	}
+
+	it.index++
+	return true
+}
+
+// Value returns the project Next just advanced to. It is only valid after a
+// call to Next that returned true.
+func (it *ProjectIterator) Value() *Project {
+	if it.index == 0 || it.index > len(it.page) {
+		return nil
+	}
+	return it.page[it.index-1]
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (it *ProjectIterator) Err() error {
+	return it.err
+}
+
+// SectionIterator walks the pages of a section listing transparently. Its
+// zero value is not usable; create one with Project.SectionsIter.
+type SectionIterator struct {
+	client  *Client
+	project *Project
+	options []*Options
+
+	started  bool
+	nextPage *NextPage
+	page     []*Section
+	index    int
+	err      error
+}
+
+// SectionsIter returns an iterator over all sections in this project,
+// paging through the results transparently as Next is called.
+func (p *Project) SectionsIter(client *Client, options ...*Options) *SectionIterator {
+	return &SectionIterator{
+		client:  client,
+		project: p,
+		options: options,
+	}
+}
+
+// Next advances the iterator to the next section, fetching the next page
+// from the API if the current one is exhausted. It returns false when
+// iteration is complete or when ctx is done at the point a new page has to
+// be fetched; sections already on the current page are still returned after
+// ctx is done. Callers should check Err to distinguish the two.
+func (it *SectionIterator) Next(ctx context.Context) bool {
+	for it.index >= len(it.page) {
+		if it.started && it.nextPage == nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		page := &Options{Limit: 100}
+		if it.nextPage != nil {
+			page.Offset = it.nextPage.Offset
+		}
+		allOptions := append([]*Options{page}, it.options...)
+
+		sections, nextPage, err := it.project.SectionsContext(ctx, it.client, allOptions...)
+		it.started = true
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page = sections
+		it.index = 0
+		it.nextPage = nextPage
+	}
+
+	it.index++
+	return true
+}
+
+// Value returns the section Next just advanced to. It is only valid after a
+// call to Next that returned true.
+func (it *SectionIterator) Value() *Section {
+	if it.index == 0 || it.index > len(it.page) {
+		return nil
+	}
+	return it.page[it.index-1]
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (it *SectionIterator) Err() error {
+	return it.err
+}
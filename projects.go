@@ -1,6 +1,7 @@
 package asana
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -142,54 +143,67 @@ type Project struct {
 
 // Fetch loads the full details for this Project
 func (p *Project) Fetch(client *Client, opts ...*Options) error {
+	return p.FetchContext(context.Background(), client, opts...)
+}
+
+// FetchContext loads the full details for this Project, honoring ctx's
+// deadline and cancellation
+func (p *Project) FetchContext(ctx context.Context, client *Client, opts ...*Options) error {
 	client.trace("Loading project details for %q", p.Name)
 
-	_, err := client.get(fmt.Sprintf("/projects/%s", p.ID), nil, p, opts...)
+	_, err := client.getWithContext(ctx, fmt.Sprintf("/projects/%s", p.ID), nil, p, opts...)
 	return err
 }
 
 // Projects returns a list of projects in this workspace
 func (w *Workspace) Projects(client *Client, options ...*Options) ([]*Project, *NextPage, error) {
+	return w.ProjectsContext(context.Background(), client, options...)
+}
+
+// ProjectsContext returns a list of projects in this workspace, honoring
+// ctx's deadline and cancellation
+func (w *Workspace) ProjectsContext(ctx context.Context, client *Client, options ...*Options) ([]*Project, *NextPage, error) {
 	client.trace("Listing projects in %q", w.Name)
 
 	var result []*Project
 
 	// Make the request
-	nextPage, err := client.get(fmt.Sprintf("/workspaces/%s/projects", w.ID), nil, &result, options...)
+	nextPage, err := client.getWithContext(ctx, fmt.Sprintf("/workspaces/%s/projects", w.ID), nil, &result, options...)
 	return result, nextPage, err
 }
 
 // AllProjects repeatedly pages through all available projects in a workspace
 func (w *Workspace) AllProjects(client *Client, options ...*Options) ([]*Project, error) {
-	var allProjects []*Project
-	nextPage := &NextPage{}
-
-	var projects []*Project
-	var err error
-
-	for nextPage != nil {
-		page := &Options{
-			Limit:  100,
-			Offset: nextPage.Offset,
-		}
+	return w.AllProjectsContext(context.Background(), client, options...)
+}
 
-		allOptions := append([]*Options{page}, options...)
-		projects, nextPage, err = w.Projects(client, allOptions...)
-		if err != nil {
-			return nil, err
-		}
+// AllProjectsContext repeatedly pages through all available projects in a
+// workspace, aborting mid-pagination if ctx is done
+func (w *Workspace) AllProjectsContext(ctx context.Context, client *Client, options ...*Options) ([]*Project, error) {
+	var allProjects []*Project
 
-		allProjects = append(allProjects, projects...)
+	it := w.ProjectsIter(client, options...)
+	for it.Next(ctx) {
+		allProjects = append(allProjects, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
 	}
 	return allProjects, nil
 }
 
 // CreateProject adds a new project to a workspace
 func (c *Client) CreateProject(project *CreateProjectRequest) (*Project, error) {
+	return c.CreateProjectContext(context.Background(), project)
+}
+
+// CreateProjectContext adds a new project to a workspace, honoring ctx's
+// deadline and cancellation
+func (c *Client) CreateProjectContext(ctx context.Context, project *CreateProjectRequest) (*Project, error) {
 	c.info("Creating project %q\n", project.Name)
 
 	result := &Project{}
 
-	err := c.post("/projects", project, result)
+	err := c.postWithContext(ctx, "/projects", project, result)
 	return result, err
 }
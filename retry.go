@@ -0,0 +1,157 @@
+package asana
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultBackoff computes an exponentially increasing delay for the given
+// retry attempt (1-indexed), jittered uniformly across the whole doubling
+// window so that concurrent clients retrying after a shared failure don't
+// all wake up at the same instant. It honors a Retry-After response header
+// (either delta-seconds or an HTTP-date) when present, falling back to
+// exponential backoff on connection errors or when the header is absent.
+func DefaultBackoff(min, max time.Duration) func(attempt int, resp *http.Response) time.Duration {
+	return func(attempt int, resp *http.Response) time.Duration {
+		if resp != nil {
+			if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				return clampDuration(wait, min, max)
+			}
+		}
+
+		// wait doubles each attempt starting at 2*min, so there's always a
+		// [min, wait] window to jitter across — flooring attempt 1's window
+		// to min (as a naive wait/2 base would) leaves no room for variance
+		// on the most common retry.
+		wait := min << uint(attempt)
+		if wait <= 0 || wait > max {
+			wait = max
+		}
+		if wait <= min {
+			return min
+		}
+
+		jitter := time.Duration(rand.Int63n(int64(wait-min) + 1))
+		return clampDuration(min+jitter, min, max)
+	}
+}
+
+func clampDuration(d, min, max time.Duration) time.Duration {
+	if d < min {
+		return min
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// parseRetryAfter parses a Retry-After header in either its seconds or
+// HTTP-date form.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+	return 0, false
+}
+
+// isRetryableStatus reports whether an HTTP status code represents a
+// transient failure worth retrying: 429 (rate limited) or any 5xx.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+type idempotentRetryKey struct{}
+
+// WithIdempotentRetry marks ctx so that a POST made with it is safe to
+// retry on connection errors, 429s, and 5xxs, just like GET/PUT/DELETE
+// already are. A POST isn't retried by default: a connection error or 5xx
+// can mean the server already applied the write and only the response was
+// lost in transit, so blindly retrying would risk creating a duplicate
+// project, webhook, section, or batch action. Only use this when the POST
+// is actually idempotent, e.g. because it carries an Asana idempotency key.
+func WithIdempotentRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentRetryKey{}, true)
+}
+
+func isIdempotentRetry(ctx context.Context) bool {
+	marked, _ := ctx.Value(idempotentRetryKey{}).(bool)
+	return marked
+}
+
+// sendWithRetry sends the request built by newRequest, retrying up to
+// Client.MaxRetries times on connection errors, 429s, and 5xxs. Retries are
+// only attempted when idempotent is true; a non-idempotent request (a POST
+// not marked with WithIdempotentRetry) is always sent exactly once,
+// regardless of MaxRetries. newRequest is invoked once per attempt so the
+// request body can be re-read from the start.
+func (c *Client) sendWithRetry(ctx context.Context, idempotent bool, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	maxRetries := c.MaxRetries
+	if !idempotent {
+		maxRetries = 0
+	}
+
+	backoff := c.Backoff
+	if backoff == nil {
+		min, max := c.RetryWaitMin, c.RetryWaitMax
+		if min <= 0 {
+			min = time.Second
+		}
+		if max <= 0 {
+			max = 30 * time.Second
+		}
+		backoff = DefaultBackoff(min, max)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		request, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.HTTPClient.Do(request)
+		if err != nil {
+			if attempt == maxRetries {
+				return nil, err
+			}
+			if waitErr := sleep(ctx, backoff(attempt+1, nil)); waitErr != nil {
+				return nil, waitErr
+			}
+			continue
+		}
+
+		if !isRetryableStatus(resp.StatusCode) || attempt == maxRetries {
+			return resp, nil
+		}
+
+		wait := backoff(attempt+1, resp)
+		resp.Body.Close()
+		if waitErr := sleep(ctx, wait); waitErr != nil {
+			return nil, waitErr
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,82 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDefaultBackoffVariesOnFirstAttempt(t *testing.T) {
+	backoff := DefaultBackoff(100*time.Millisecond, time.Second)
+
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 50; i++ {
+		d := backoff(1, nil)
+		if d < 100*time.Millisecond || d > 200*time.Millisecond {
+			t.Fatalf("attempt 1 backoff %v outside expected [min, 2*min] window", d)
+		}
+		seen[d] = true
+	}
+
+	if len(seen) < 2 {
+		t.Fatalf("attempt 1 backoff produced no variance across 50 runs (all %v): thundering herd not avoided", seen)
+	}
+}
+
+func TestDefaultBackoffGrowsAndCapsAtMax(t *testing.T) {
+	backoff := DefaultBackoff(time.Second, 3*time.Second)
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		d := backoff(attempt, nil)
+		if d < time.Second || d > 3*time.Second {
+			t.Fatalf("attempt %d backoff %v outside [min, max]", attempt, d)
+		}
+	}
+}
+
+func TestDefaultBackoffHonorsRetryAfterSeconds(t *testing.T) {
+	backoff := DefaultBackoff(time.Second, time.Minute)
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}
+
+	if d := backoff(1, resp); d != 5*time.Second {
+		t.Fatalf("got %v, want 5s", d)
+	}
+}
+
+func TestDefaultBackoffHonorsRetryAfterDate(t *testing.T) {
+	backoff := DefaultBackoff(time.Second, time.Minute)
+	when := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{when}}}
+
+	d := backoff(1, resp)
+	if d < 8*time.Second || d > 11*time.Second {
+		t.Fatalf("got %v, want ~10s", d)
+	}
+}
+
+func TestParseRetryAfterSeconds(t *testing.T) {
+	d, ok := parseRetryAfter("30")
+	if !ok || d != 30*time.Second {
+		t.Fatalf("got (%v, %v), want (30s, true)", d, ok)
+	}
+}
+
+func TestParseRetryAfterDate(t *testing.T) {
+	when := time.Now().Add(2 * time.Minute).UTC().Format(http.TimeFormat)
+	d, ok := parseRetryAfter(when)
+	if !ok || d < time.Minute || d > 3*time.Minute {
+		t.Fatalf("got (%v, %v), want (~2m, true)", d, ok)
+	}
+}
+
+func TestParseRetryAfterMissing(t *testing.T) {
+	if _, ok := parseRetryAfter(""); ok {
+		t.Fatal("expected ok=false for an empty header")
+	}
+}
+
+func TestParseRetryAfterInvalid(t *testing.T) {
+	if _, ok := parseRetryAfter("not-a-duration"); ok {
+		t.Fatal("expected ok=false for an unparseable header")
+	}
+}
@@ -1,6 +1,9 @@
 package asana
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type SectionBase struct {
 	WithName
@@ -32,34 +35,52 @@ func (c *Client) Section(id string) *Section {
 
 // Expand loads the full details for this Section
 func (s *Section) Expand(client *Client) error {
+	return s.ExpandContext(context.Background(), client)
+}
+
+// ExpandContext loads the full details for this Section, honoring ctx's
+// deadline and cancellation
+func (s *Section) ExpandContext(ctx context.Context, client *Client) error {
 	client.trace("Loading section details for %q", s.Name)
 
 	if s.expanded {
 		return nil
 	}
 
-	_, err := client.get(fmt.Sprintf("/sections/%s", s.ID), nil, s)
+	_, err := client.getWithContext(ctx, fmt.Sprintf("/sections/%s", s.ID), nil, s)
 	return err
 }
 
 // Sections returns a list of sections in this project
 func (p *Project) Sections(client *Client, opts ...*Options) ([]*Section, *NextPage, error) {
+	return p.SectionsContext(context.Background(), client, opts...)
+}
+
+// SectionsContext returns a list of sections in this project, honoring ctx's
+// deadline and cancellation
+func (p *Project) SectionsContext(ctx context.Context, client *Client, opts ...*Options) ([]*Section, *NextPage, error) {
 	client.trace("Listing sections in %q", p.Name)
 	var result []*Section
 
 	// Make the request
-	nextPage, err := client.get(fmt.Sprintf("/projects/%s/sections", p.ID), nil, &result, opts...)
+	nextPage, err := client.getWithContext(ctx, fmt.Sprintf("/projects/%s/sections", p.ID), nil, &result, opts...)
 	return result, nextPage, err
 }
 
 // CreateSection creates a new section in the given project
 func (p *Project) CreateSection(client *Client, section *SectionBase) (*Section, error) {
+	return p.CreateSectionContext(context.Background(), client, section)
+}
+
+// CreateSectionContext creates a new section in the given project, honoring
+// ctx's deadline and cancellation
+func (p *Project) CreateSectionContext(ctx context.Context, client *Client, section *SectionBase) (*Section, error) {
 	client.info("Creating section %q", section.Name)
 
 	result := &Section{}
 	result.expanded = true
 
-	err := client.post(fmt.Sprintf("projects/%s/sections", p.ID), section, result)
+	err := client.postWithContext(ctx, fmt.Sprintf("/projects/%s/sections", p.ID), section, result)
 	return result, err
 }
 
@@ -77,8 +98,14 @@ type SectionInsertRequest struct {
 //
 // At this point in time, moving sections is not supported in list views, only board views.
 func (p *Project) InsertSection(client *Client, request *SectionInsertRequest) error {
+	return p.InsertSectionContext(context.Background(), client, request)
+}
+
+// InsertSectionContext moves sections relative to each other in a board
+// view, honoring ctx's deadline and cancellation
+func (p *Project) InsertSectionContext(ctx context.Context, client *Client, request *SectionInsertRequest) error {
 	client.info("Moving section %s", request.Section)
 
-	err := client.post(fmt.Sprintf("projects/%s/sections/insert", p.ID), request, nil)
+	err := client.postWithContext(ctx, fmt.Sprintf("/projects/%s/sections/insert", p.ID), request, nil)
 	return err
 }
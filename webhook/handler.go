@@ -0,0 +1,188 @@
+// Package webhook implements the receiving side of Asana webhooks: the
+// X-Hook-Secret handshake, X-Hook-Signature verification, and dispatch of
+// incoming events to typed callbacks.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	asana "bitbucket.org/mikehouston/asana-go"
+)
+
+// SecretStore persists the per-webhook secret handed out during the
+// X-Hook-Secret handshake so it can be used to verify signatures on
+// subsequent deliveries. key identifies the webhook, e.g. its target path.
+type SecretStore interface {
+	GetSecret(key string) (secret string, ok bool, err error)
+	SetSecret(key, secret string) error
+}
+
+// Event is a single entry from the events array of a webhook delivery.
+type Event struct {
+	User      *asana.User `json:"user"`
+	Resource  *Resource   `json:"resource"`
+	Action    string      `json:"action"`
+	Change    *Change     `json:"change,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Resource is the compact representation of the object an Event describes.
+type Resource struct {
+	ID           string `json:"gid"`
+	ResourceType string `json:"resource_type"`
+}
+
+// Change describes what was added, changed, or removed on the resource, for
+// "changed", "added", and "removed" actions.
+type Change struct {
+	Field    string      `json:"field"`
+	Action   string      `json:"action"`
+	NewValue interface{} `json:"new_value,omitempty"`
+}
+
+type envelope struct {
+	Events []Event `json:"events"`
+}
+
+// Handler implements http.Handler for the receiving side of an Asana
+// webhook: it completes the X-Hook-Secret handshake on first delivery,
+// verifies X-Hook-Signature on every subsequent delivery, and dispatches
+// each event in the payload to the callbacks registered with On.
+type Handler struct {
+	// Store persists the secret negotiated during the handshake.
+	Store SecretStore
+
+	// Key identifies which webhook a request belongs to, e.g. by the
+	// target path it was posted to. Handler serves a single webhook when
+	// Key is nil.
+	Key func(r *http.Request) string
+
+	callbacks map[string][]func(Event)
+}
+
+// NewHandler creates a Handler that persists secrets in store. key may be
+// nil if this Handler only ever serves a single webhook.
+func NewHandler(store SecretStore, key func(r *http.Request) string) *Handler {
+	return &Handler{
+		Store:     store,
+		Key:       key,
+		callbacks: map[string][]func(Event){},
+	}
+}
+
+// On registers callback to run for every event whose Action matches action
+// (e.g. "changed", "added", "removed", "deleted", "undeleted").
+func (h *Handler) On(action string, callback func(Event)) {
+	if h.callbacks == nil {
+		h.callbacks = map[string][]func(Event){}
+	}
+	h.callbacks[action] = append(h.callbacks[action], callback)
+}
+
+// OnTaskChanged registers callback to run for "changed" events on tasks.
+func (h *Handler) OnTaskChanged(callback func(Event)) {
+	h.On("changed", func(e Event) {
+		if e.Resource != nil && e.Resource.ResourceType == "task" {
+			callback(e)
+		}
+	})
+}
+
+// OnProjectAdded registers callback to run for "added" events on projects.
+func (h *Handler) OnProjectAdded(callback func(Event)) {
+	h.On("added", func(e Event) {
+		if e.Resource != nil && e.Resource.ResourceType == "project" {
+			callback(e)
+		}
+	})
+}
+
+func (h *Handler) key(r *http.Request) string {
+	if h.Key == nil {
+		return ""
+	}
+	return h.Key(r)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := h.key(r)
+
+	if secret := r.Header.Get("X-Hook-Secret"); secret != "" {
+		// The X-Hook-Secret header is attacker-controlled, so only honor it
+		// as the genuine handshake when no secret is on record yet for this
+		// webhook. Once established, a secret is never overwritten by a
+		// later request claiming to be "the" handshake.
+		_, alreadyEstablished, err := h.Store.GetSecret(key)
+		if err != nil {
+			http.Error(w, "failed to read webhook secret", http.StatusInternalServerError)
+			return
+		}
+		if alreadyEstablished {
+			http.Error(w, "webhook secret already established", http.StatusForbidden)
+			return
+		}
+
+		if err := h.Store.SetSecret(key, secret); err != nil {
+			http.Error(w, "failed to persist webhook secret", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Hook-Secret", secret)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	secret, ok, err := h.Store.GetSecret(key)
+	if err != nil || !ok {
+		http.Error(w, "unknown webhook secret", http.StatusUnauthorized)
+		return
+	}
+
+	if !verifySignature(secret, body, r.Header.Get("X-Hook-Signature")) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range env.Events {
+		for _, callback := range h.callbacks[event.Action] {
+			callback(event)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func verifySignature(secret string, body []byte, signature string) bool {
+	if signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
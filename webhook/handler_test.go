@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type memoryStore struct {
+	secrets map[string]string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{secrets: map[string]string{}}
+}
+
+func (s *memoryStore) GetSecret(key string) (string, bool, error) {
+	secret, ok := s.secrets[key]
+	return secret, ok, nil
+}
+
+func (s *memoryStore) SetSecret(key, secret string) error {
+	s.secrets[key] = secret
+	return nil
+}
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandlerHandshake(t *testing.T) {
+	store := newMemoryStore()
+	h := NewHandler(store, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("X-Hook-Secret", "top-secret")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("handshake: got status %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("X-Hook-Secret"); got != "top-secret" {
+		t.Fatalf("handshake: echoed secret %q, want %q", got, "top-secret")
+	}
+
+	secret, ok, err := store.GetSecret("")
+	if err != nil || !ok || secret != "top-secret" {
+		t.Fatalf("handshake: secret not persisted, got (%q, %v, %v)", secret, ok, err)
+	}
+}
+
+func TestHandlerRejectsHandshakeReplay(t *testing.T) {
+	store := newMemoryStore()
+	h := NewHandler(store, nil)
+
+	first := httptest.NewRequest(http.MethodPost, "/", nil)
+	first.Header.Set("X-Hook-Secret", "real-secret")
+	h.ServeHTTP(httptest.NewRecorder(), first)
+
+	// An attacker who can reach the endpoint tries to "re-handshake" with a
+	// secret of their own choosing, hoping to overwrite the stored one.
+	attack := httptest.NewRequest(http.MethodPost, "/", nil)
+	attack.Header.Set("X-Hook-Secret", "attacker-secret")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, attack)
+
+	if w.Code == http.StatusOK {
+		t.Fatalf("replayed handshake: got status %d, want a rejection", w.Code)
+	}
+
+	secret, ok, err := store.GetSecret("")
+	if err != nil || !ok || secret != "real-secret" {
+		t.Fatalf("stored secret was clobbered by replay, got (%q, %v, %v)", secret, ok, err)
+	}
+}
+
+func TestHandlerDispatchesVerifiedEvent(t *testing.T) {
+	store := newMemoryStore()
+	store.SetSecret("", "shared-secret")
+
+	h := NewHandler(store, nil)
+
+	var received Event
+	calls := 0
+	h.OnTaskChanged(func(e Event) {
+		calls++
+		received = e
+	})
+
+	body := `{"events":[{"user":{"gid":"1"},"resource":{"gid":"42","resource_type":"task"},"action":"changed","created_at":"2020-01-01T00:00:00Z"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("X-Hook-Signature", sign("shared-secret", body))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusOK)
+	}
+	if calls != 1 {
+		t.Fatalf("OnTaskChanged called %d times, want 1", calls)
+	}
+	if received.Resource == nil || received.Resource.ID != "42" {
+		t.Fatalf("dispatched event had unexpected resource: %+v", received.Resource)
+	}
+}
+
+func TestHandlerRejectsTamperedBody(t *testing.T) {
+	store := newMemoryStore()
+	store.SetSecret("", "shared-secret")
+
+	h := NewHandler(store, nil)
+	h.OnTaskChanged(func(Event) {
+		t.Fatal("callback should not run for a tampered body")
+	})
+
+	signedBody := `{"events":[{"resource":{"gid":"1","resource_type":"task"},"action":"changed"}]}`
+	signature := sign("shared-secret", signedBody)
+
+	tamperedBody := `{"events":[{"resource":{"gid":"999","resource_type":"task"},"action":"changed"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tamperedBody))
+	req.Header.Set("X-Hook-Signature", signature)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("tampered body: got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestHandlerRejectsMissingSignature(t *testing.T) {
+	store := newMemoryStore()
+	store.SetSecret("", "shared-secret")
+
+	h := NewHandler(store, nil)
+
+	body := `{"events":[]}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("missing signature: got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,110 @@
+package asana
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WebhookFilter narrows the events a Webhook is notified about to a specific
+// resource type, action, and (for "changed" actions) set of fields.
+type WebhookFilter struct {
+	ResourceType    string   `json:"resource_type,omitempty"`
+	ResourceSubtype string   `json:"resource_subtype,omitempty"`
+	Action          string   `json:"action,omitempty"`
+	Fields          []string `json:"fields,omitempty"`
+}
+
+// CreateWebhookRequest represents a request to register a new webhook
+type CreateWebhookRequest struct {
+	Resource string          `json:"resource"`
+	Target   string          `json:"target"`
+	Filters  []WebhookFilter `json:"filters,omitempty"`
+}
+
+// WebhookResource is the compact representation of the resource a Webhook
+// is subscribed to.
+type WebhookResource struct {
+	ID           string `json:"gid,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// Webhook represents a subscription that notifies target whenever resource
+// changes.
+type Webhook struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// Read-only. The resource this webhook is subscribed to.
+	Resource *WebhookResource `json:"resource,omitempty"`
+
+	// Read-only. The URL events for this webhook are posted to.
+	Target string `json:"target,omitempty"`
+
+	// Read-only. Whether the webhook has completed the X-Hook-Secret
+	// handshake and is actively delivering events.
+	Active bool `json:"active,omitempty"`
+
+	// The list of filters limiting the events this webhook is notified
+	// about.
+	Filters []WebhookFilter `json:"filters,omitempty"`
+
+	// Read-only. The time at which this object was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// CreateWebhook registers a new webhook that posts events for resource to
+// target, optionally narrowed by filters.
+func (c *Client) CreateWebhook(resource, target string, filters []WebhookFilter) (*Webhook, error) {
+	return c.CreateWebhookContext(context.Background(), resource, target, filters)
+}
+
+// CreateWebhookContext registers a new webhook, honoring ctx's deadline and
+// cancellation.
+func (c *Client) CreateWebhookContext(ctx context.Context, resource, target string, filters []WebhookFilter) (*Webhook, error) {
+	c.info("Creating webhook for %q -> %q\n", resource, target)
+
+	result := &Webhook{}
+	request := &CreateWebhookRequest{
+		Resource: resource,
+		Target:   target,
+		Filters:  filters,
+	}
+
+	err := c.postWithContext(ctx, "/webhooks", request, result)
+	return result, err
+}
+
+// DeleteWebhook removes the webhook with the given ID
+func (c *Client) DeleteWebhook(id string) error {
+	return c.DeleteWebhookContext(context.Background(), id)
+}
+
+// DeleteWebhookContext removes the webhook with the given ID, honoring ctx's
+// deadline and cancellation.
+func (c *Client) DeleteWebhookContext(ctx context.Context, id string) error {
+	c.info("Deleting webhook %q\n", id)
+
+	return c.deleteWithContext(ctx, fmt.Sprintf("/webhooks/%s", id))
+}
+
+// Webhooks returns a list of webhooks in this workspace
+func (c *Client) Webhooks(workspace string, opts ...*Options) ([]*Webhook, *NextPage, error) {
+	return c.WebhooksContext(context.Background(), workspace, opts...)
+}
+
+// WebhooksContext returns a list of webhooks in this workspace, honoring
+// ctx's deadline and cancellation.
+func (c *Client) WebhooksContext(ctx context.Context, workspace string, opts ...*Options) ([]*Webhook, *NextPage, error) {
+	c.trace("Listing webhooks in %q", workspace)
+
+	var result []*Webhook
+
+	data := struct {
+		Workspace string `url:"workspace"`
+	}{workspace}
+
+	nextPage, err := c.getWithContext(ctx, "/webhooks", &data, &result, opts...)
+	return result, nextPage, err
+}